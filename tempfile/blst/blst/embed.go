@@ -0,0 +1,110 @@
+package blst
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// FS embeds the blst headers, assembly sources, and C glue so that
+// SrcRoot can be recovered even when none of that is present on disk.
+//
+//go:embed all:bindings all:src all:build
+var FS embed.FS
+
+// SourceFS returns the embedded blst sources, letting callers walk the
+// tree without touching disk.
+func SourceFS() fs.FS {
+	return FS
+}
+
+// cacheSubdir is the directory created under os.UserCacheDir to hold
+// materialized copies of FS, one per content hash.
+const cacheSubdir = "foundry-blst"
+
+// extractedRoot materializes FS into a per-user cache directory keyed by
+// the content hash of the embedded tree, so extraction is a one-time cost
+// per version of the embedded sources rather than per build.
+func extractedRoot() (string, error) {
+	sum, err := hashTree(FS)
+	if err != nil {
+		return "", err
+	}
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(base, cacheSubdir, sum)
+	if _, err := os.Stat(dir); err == nil {
+		return dir, nil
+	}
+
+	// Extract into a sibling temp directory first and rename into place so
+	// concurrent builds racing to populate the same cache entry can't
+	// observe a partially written tree.
+	if err := os.MkdirAll(filepath.Dir(dir), 0o755); err != nil {
+		return "", err
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(dir), sum+".tmp-")
+	if err != nil {
+		return "", err
+	}
+	if err := extractTree(FS, tmp); err != nil {
+		os.RemoveAll(tmp)
+		return "", err
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		os.RemoveAll(tmp)
+		if _, statErr := os.Stat(dir); statErr != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+func extractTree(src fs.FS, dst string) error {
+	return fs.WalkDir(src, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, path)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		data, err := fs.ReadFile(src, path)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+		return os.WriteFile(target, data, 0o644)
+	})
+}
+
+func hashTree(src fs.FS) (string, error) {
+	h := sha256.New()
+	err := fs.WalkDir(src, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		f, err := src.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		io.WriteString(h, path)
+		if _, err := io.Copy(h, f); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
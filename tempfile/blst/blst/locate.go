@@ -0,0 +1,72 @@
+package blst
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// srcRootEnv lets callers pin a specific blst checkout — for example to
+// cross-compile against a source tree other than the one vendored
+// alongside this module — the same way GOROOT can be overridden to point
+// Go itself at an alternate toolchain checkout.
+const srcRootEnv = "FOUNDRY_BLST_SRC"
+
+// sentinels are files that must be present under a candidate directory
+// before Locate accepts it as a blst source root.
+var sentinels = []string{
+	filepath.Join("bindings", "blst.h"),
+	filepath.Join("build", "assembly.S"),
+}
+
+// ErrSrcRootMissing is returned by Locate when none of its resolution
+// strategies produce a directory that looks like a blst checkout.
+var ErrSrcRootMissing = errors.New("blst: could not locate source root")
+
+// Locate resolves the directory containing the vendored blst sources, in
+// order of preference:
+//
+//  1. The FOUNDRY_BLST_SRC environment variable, if set to an absolute
+//     path.
+//  2. The directory containing this file, which is correct when the
+//     module is built from a checked-out source tree.
+//  3. A copy of the embedded sources (see FS), materialized into the
+//     user's cache directory.
+//
+// Each candidate is validated against sentinels before being accepted;
+// Locate returns ErrSrcRootMissing if none pass. A FOUNDRY_BLST_SRC value
+// that is not absolute is an error of its own: Locate returns it at once
+// without trying the other candidates.
+func Locate() (string, error) {
+	if env := os.Getenv(srcRootEnv); env != "" {
+		if !filepath.IsAbs(env) {
+			return "", fmt.Errorf("blst: %s must be an absolute path, got %q", srcRootEnv, env)
+		}
+		if validSrcRoot(env) {
+			return env, nil
+		}
+	}
+
+	if _, self, _, ok := runtime.Caller(0); ok {
+		if dir := filepath.Dir(self); validSrcRoot(dir) {
+			return dir, nil
+		}
+	}
+
+	if dir, err := extractedRoot(); err == nil && validSrcRoot(dir) {
+		return dir, nil
+	}
+
+	return "", ErrSrcRootMissing
+}
+
+func validSrcRoot(dir string) bool {
+	for _, sentinel := range sentinels {
+		if _, err := os.Stat(filepath.Join(dir, sentinel)); err != nil {
+			return false
+		}
+	}
+	return true
+}
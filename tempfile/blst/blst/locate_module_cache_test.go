@@ -0,0 +1,90 @@
+package blst
+
+import (
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// TestLocateModuleCache simulates consuming this package from the module
+// cache: it builds testdata/probe from a scratch copy of this module,
+// deletes that copy so the runtime.Caller path baked into the binary at
+// compile time no longer exists, then runs the binary with GOROOT and
+// GOPATH unset and checks that it still falls back to the embedded
+// sources instead of erroring out.
+func TestLocateModuleCache(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a binary; skipped in -short mode")
+	}
+
+	srcCopy := t.TempDir()
+	if err := copyModule(".", srcCopy); err != nil {
+		t.Fatalf("copying module: %v", err)
+	}
+
+	binDir := t.TempDir()
+	bin := filepath.Join(binDir, "probe")
+	if runtime.GOOS == "windows" {
+		bin += ".exe"
+	}
+
+	build := exec.Command("go", "build", "-o", bin, "./testdata/probe")
+	build.Dir = srcCopy
+	if out, err := build.CombinedOutput(); err != nil {
+		t.Fatalf("building probe: %v\n%s", err, out)
+	}
+
+	// Delete the checkout the binary was built from: its compiled-in
+	// runtime.Caller path now points nowhere, so Locate is forced past
+	// that candidate and onto the embedded fallback.
+	if err := os.RemoveAll(srcCopy); err != nil {
+		t.Fatalf("removing source copy: %v", err)
+	}
+
+	cmd := exec.Command(bin)
+	cmd.Dir = binDir
+	cmd.Env = append(os.Environ(), "GOROOT=", "GOPATH=")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("running probe: %v\n%s", err, out)
+	}
+
+	got := strings.TrimSpace(string(out))
+	if strings.HasPrefix(got, "error:") {
+		t.Fatalf("probe could not locate a source root: %s", got)
+	}
+	if strings.HasPrefix(got, srcCopy) {
+		t.Fatalf("probe resolved to the deleted checkout instead of falling back: %s", got)
+	}
+}
+
+// copyModule recursively copies src into dst, skipping VCS metadata, so
+// a built binary's compiled-in source path can be safely deleted without
+// touching the real checkout.
+func copyModule(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Name() == ".git" {
+			return fs.SkipDir
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, data, 0o644)
+	})
+}
@@ -0,0 +1,21 @@
+package blst
+
+import "log"
+
+// SrcRoot is the directory containing the vendored blst sources (headers,
+// assembly, and C glue) that cgo builds compile against. It is populated by Locate; see that function for the
+// resolution order. SrcRoot is kept as a package variable for backward
+// compatibility with callers that read it directly instead of calling
+// Locate themselves.
+var SrcRoot string
+
+func init() {
+	dir, err := Locate()
+	if err != nil {
+		log.Printf("blst: %v; falling back to embedded sources", err)
+		if fallback, extractErr := extractedRoot(); extractErr == nil {
+			dir = fallback
+		}
+	}
+	SrcRoot = dir
+}
@@ -0,0 +1,20 @@
+// Command probe reports the blst source root it resolves to. It exists
+// so TestLocateModuleCache can build it, relocate the binary away from
+// any checked-out source tree, and observe that Locate still finds a
+// valid root via the embedded fallback.
+package main
+
+import (
+	"fmt"
+
+	blst "github.com/foundry-rs/foundry/tempfile/blst/blst"
+)
+
+func main() {
+	root, err := blst.Locate()
+	if err != nil {
+		fmt.Println("error:", err)
+		return
+	}
+	fmt.Println(root)
+}
@@ -0,0 +1,85 @@
+package blst
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+)
+
+// skipNames are directories excluded from Hash: they carry no
+// build-relevant content and, in the case of VCS metadata, would make the
+// hash depend on how the tree was checked out rather than its contents.
+var skipNames = map[string]bool{
+	".git": true,
+	".hg":  true,
+}
+
+// Tree returns a filesystem rooted at the blst source directory, backed
+// by os.DirFS(SrcRoot) when that directory actually looks like a blst
+// checkout and by the embedded copy otherwise.
+func Tree() fs.FS {
+	if validSrcRoot(SrcRoot) {
+		return os.DirFS(SrcRoot)
+	}
+	return SourceFS()
+}
+
+// Hash walks Tree deterministically — sorted paths, hashing file content
+// and mode bits — and returns a stable SHA-256 digest of the current blst
+// sources.
+//
+// Symlinked directories are treated as an indexing error rather than
+// followed, so the hash stays reproducible across platforms; VCS
+// metadata directories are skipped.
+func Hash() (string, error) {
+	tree := Tree()
+
+	var paths []string
+	err := fs.WalkDir(tree, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if skipNames[d.Name()] {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if info, err := d.Info(); err == nil && info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("blst: %s: symlinks are not supported in a hashed tree", path)
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(paths)
+
+	h := sha256.New()
+	for _, path := range paths {
+		info, err := fs.Stat(tree, path)
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("blst: %s: symlinks are not supported in a hashed tree", path)
+		}
+		fmt.Fprintf(h, "%s %o\n", path, info.Mode().Perm())
+
+		f, err := tree.Open(path)
+		if err != nil {
+			return "", err
+		}
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}